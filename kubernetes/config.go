@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+// KubeClientsets is the interface implemented by the provider's meta value.
+// Resources and data sources type-assert meta.(KubeClientsets) to reach a
+// typed Kubernetes clientset without depending on how the provider builds or
+// caches its REST config.
+type KubeClientsets interface {
+	MainClientset() (*kubernetes.Clientset, error)
+	GatewayClientset() (gatewayclientset.Interface, error)
+}
+
+// kubeClientsets is the concrete KubeClientsets backing the provider. Each
+// clientset is constructed lazily from the shared REST config and cached so
+// that repeated calls during a single apply reuse the same connection.
+type kubeClientsets struct {
+	config *restclient.Config
+
+	mainClientsetOnce sync.Once
+	mainClientset     *kubernetes.Clientset
+	mainClientsetErr  error
+
+	gatewayClientsetOnce sync.Once
+	gatewayClientset     gatewayclientset.Interface
+	gatewayClientsetErr  error
+}
+
+// MainClientset returns the core Kubernetes clientset used by most resources
+// and data sources in this provider.
+func (k *kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
+	k.mainClientsetOnce.Do(func() {
+		if k.config == nil {
+			k.mainClientsetErr = fmt.Errorf("provider not configured")
+			return
+		}
+		k.mainClientset, k.mainClientsetErr = kubernetes.NewForConfig(k.config)
+	})
+	return k.mainClientset, k.mainClientsetErr
+}
+
+// GatewayClientset returns the Gateway API clientset used by the
+// kubernetes_gateway_v1, kubernetes_gatewayclass_v1, kubernetes_httproute_v1,
+// kubernetes_grpcroute_v1 and kubernetes_referencegrant_v1beta1 resources and
+// their data source counterparts.
+func (k *kubeClientsets) GatewayClientset() (gatewayclientset.Interface, error) {
+	k.gatewayClientsetOnce.Do(func() {
+		if k.config == nil {
+			k.gatewayClientsetErr = fmt.Errorf("provider not configured")
+			return
+		}
+		k.gatewayClientset, k.gatewayClientsetErr = gatewayclientset.NewForConfig(k.config)
+	})
+	return k.gatewayClientset, k.gatewayClientsetErr
+}
@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,9 @@ func dataSourceKubernetesIngressV1() *schema.Resource {
 
 	return &schema.Resource{
 		ReadContext: dataSourceKubernetesIngressV1Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("ingress", false),
 			"spec": {
@@ -143,6 +147,13 @@ func dataSourceKubernetesIngressV1() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the load balancer to have at least 1 endpoint (an `ip` or `hostname`) before returning the ingress status.",
+				Optional:    true,
+				Default:     false,
+			},
+			"controller_annotations": controllerAnnotationsFieldsV1(true),
 		},
 	}
 }
@@ -171,6 +182,16 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
 
+	if d.Get("wait_for_load_balancer").(bool) && len(ing.Status.LoadBalancer.Ingress) == 0 {
+		if err := waitIngressLoadBalancer(ctx, conn, metadata.Namespace, metadata.Name, d.Timeout(schema.TimeoutRead)); err != nil {
+			return diag.FromErr(err)
+		}
+		ing, err = conn.NetworkingV1().Ingresses(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = d.Set("metadata", flattenMetadataFields(ing.ObjectMeta))
 	if err != nil {
 		return diag.FromErr(err)
@@ -190,5 +211,10 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("controller_annotations", flattenControllerAnnotationsV1(ing.ObjectMeta.Annotations))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
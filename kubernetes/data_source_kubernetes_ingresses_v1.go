@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesIngressesV1() *schema.Resource {
+	docIngress := networking.Ingress{}.SwaggerDoc()
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesIngressesV1Read,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Limit the search to this namespace. Leave empty to search across all namespaces.",
+				Optional:    true,
+			},
+			"label_selector": {
+				Type:        schema.TypeString,
+				Description: "A label query to filter the ingresses on, in the same format as `kubectl get --selector`.",
+				Optional:    true,
+			},
+			"field_selector": {
+				Type:        schema.TypeString,
+				Description: "A field query to filter the ingresses on, in the same format as `kubectl get --field-selector`.",
+				Optional:    true,
+			},
+			"ingress_class_name": {
+				Type:        schema.TypeString,
+				Description: "Limit the results to ingresses whose `spec.ingressClassName` matches this value.",
+				Optional:    true,
+			},
+			"ingresses": {
+				Type:        schema.TypeList,
+				Description: "The list of ingresses matching the given selectors.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": namespacedMetadataSchema("ingress", false),
+						"spec": {
+							Type:        schema.TypeList,
+							Description: docIngress["spec"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: ingressV1SpecFieldsComputed(),
+							},
+						},
+						"status": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"load_balancer": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ingress": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ip": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"hostname": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ingressV1SpecFieldsComputed returns the computed-only spec schema used for
+// each entry of the plural ingress data source's `ingresses` list.
+func ingressV1SpecFieldsComputed() map[string]*schema.Schema {
+	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
+	docIngressRule := networking.IngressRule{}.SwaggerDoc()
+	docIngressSpec := networking.IngressSpec{}.SwaggerDoc()
+
+	return map[string]*schema.Schema{
+		"ingress_class_name": {
+			Type:        schema.TypeString,
+			Description: docIngressSpec["ingressClassName"],
+			Computed:    true,
+		},
+		"default_backend": backendSpecFieldsV1(defaultBackendDescriptionV1),
+		"rule": {
+			Type:        schema.TypeList,
+			Description: docIngressSpec["rules"],
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Type:        schema.TypeString,
+						Description: docIngressRule["host"],
+						Computed:    true,
+					},
+					"http": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: docIngressRule[""],
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:        schema.TypeList,
+									Computed:    true,
+									Description: docHTTPIngressRuleValue["paths"],
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"path": {
+												Type:        schema.TypeString,
+												Description: docHTTPIngressPath["path"],
+												Computed:    true,
+											},
+											"path_type": {
+												Type:        schema.TypeString,
+												Description: docHTTPIngressPath["pathType"],
+												Computed:    true,
+											},
+											"backend": backendSpecFieldsV1(ruleBackedDescriptionV1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"tls": {
+			Type:        schema.TypeList,
+			Description: docIngressSpec["tls"],
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hosts": {
+						Type:        schema.TypeList,
+						Description: docIngressTLS["hosts"],
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"secret_name": {
+						Type:        schema.TypeString,
+						Description: docIngressTLS["secretName"],
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ingressMatchesClassNameFilter reports whether ing should be included given
+// the data source's `ingress_class_name` filter. An empty filter matches
+// every Ingress; a non-empty filter only matches when `spec.ingressClassName`
+// is set and equal to it.
+func ingressMatchesClassNameFilter(ing networking.Ingress, ingressClassName string) bool {
+	if ingressClassName == "" {
+		return true
+	}
+	return ing.Spec.IngressClassName != nil && *ing.Spec.IngressClassName == ingressClassName
+}
+
+func dataSourceKubernetesIngressesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	labelSelector := d.Get("label_selector").(string)
+	fieldSelector := d.Get("field_selector").(string)
+	ingressClassName := d.Get("ingress_class_name").(string)
+
+	log.Printf("[INFO] Listing ingresses in namespace %q matching label selector %q and field selector %q", namespace, labelSelector, fieldSelector)
+	list, err := conn.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received %d ingresses", len(list.Items))
+
+	ingresses := make([]interface{}, 0, len(list.Items))
+	for _, ing := range list.Items {
+		if !ingressMatchesClassNameFilter(ing, ingressClassName) {
+			continue
+		}
+
+		ingresses = append(ingresses, map[string]interface{}{
+			"metadata": flattenMetadataFields(ing.ObjectMeta),
+			"spec":     flattenIngressV1Spec(ing.Spec),
+			"status": []interface{}{
+				map[string][]interface{}{
+					"load_balancer": flattenIngressV1Status(ing.Status.LoadBalancer),
+				},
+			},
+		})
+	}
+
+	err = d.Set("ingresses", ingresses)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s,%s", namespace, labelSelector, fieldSelector, ingressClassName))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	networking "k8s.io/api/networking/v1"
+)
+
+func TestIngressMatchesClassNameFilterEmptyFilterMatchesAll(t *testing.T) {
+	ing := networking.Ingress{}
+	if !ingressMatchesClassNameFilter(ing, "") {
+		t.Error("expected an empty filter to match an ingress with no ingressClassName")
+	}
+}
+
+func TestIngressMatchesClassNameFilterMatch(t *testing.T) {
+	className := "nginx"
+	ing := networking.Ingress{
+		Spec: networking.IngressSpec{IngressClassName: &className},
+	}
+	if !ingressMatchesClassNameFilter(ing, "nginx") {
+		t.Error("expected the filter to match an ingress with the same ingressClassName")
+	}
+}
+
+func TestIngressMatchesClassNameFilterMismatch(t *testing.T) {
+	className := "nginx"
+	ing := networking.Ingress{
+		Spec: networking.IngressSpec{IngressClassName: &className},
+	}
+	if ingressMatchesClassNameFilter(ing, "traefik") {
+		t.Error("expected the filter not to match an ingress with a different ingressClassName")
+	}
+}
+
+func TestIngressMatchesClassNameFilterNilClassName(t *testing.T) {
+	ing := networking.Ingress{}
+	if ingressMatchesClassNameFilter(ing, "nginx") {
+		t.Error("expected the filter not to match an ingress with no ingressClassName set")
+	}
+}
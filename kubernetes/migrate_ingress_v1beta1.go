@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	networking "k8s.io/api/networking/v1"
+	extensionsv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// The legacy `kubernetes_ingress` (extensions/v1beta1, networking.k8s.io/v1beta1)
+// resource and data source are deprecated in favor of `kubernetes_ingress_v1`.
+// deprecatedIngressV1beta1ImportPrefix is the import ID prefix that tells the
+// importer to read the Ingress through the deprecated v1beta1 API (still served
+// by clusters older than 1.22) and run it through the conversion helpers below
+// before falling back to the normal v1 import, so the migration is verified
+// against the object the user actually has, not just the post-upgrade v1 view.
+const deprecatedIngressV1beta1ImportPrefix = "v1beta1/"
+
+// resourceKubernetesIngressV1Import is the StateContextFunc for
+// kubernetes_ingress_v1. It accepts the regular `namespace/name` import ID, and
+// additionally a `v1beta1/namespace/name` ID for migrating a resource that was
+// previously managed as the deprecated kubernetes_ingress (v1beta1) resource.
+// In the v1beta1 case it tries the deprecated networking.k8s.io/v1beta1 API
+// first and runs the result through convertIngressV1beta1SpecToV1, so the
+// migration is verified against the object the user actually had. The
+// v1beta1 API was removed from the apiserver in Kubernetes 1.22, so on a
+// cluster where that Get fails this falls back to reading the same object
+// through the v1 API, which the apiserver has served since Ingress GA'd in
+// 1.19 - the migration works whether or not the source cluster still speaks
+// v1beta1. Either way the resulting metadata and spec are set on d directly,
+// so the conversion is reflected in the very first plan after import instead
+// of only in whatever the next Read happens to fetch.
+func resourceKubernetesIngressV1Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if !strings.HasPrefix(id, deprecatedIngressV1beta1ImportPrefix) {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+	id = strings.TrimPrefix(id, deprecatedIngressV1beta1ImportPrefix)
+
+	namespace, name, err := idParts(id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	var ing *networking.Ingress
+	if legacy, err := conn.NetworkingV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+		log.Printf("[INFO] Migrating deprecated kubernetes_ingress %s/%s to kubernetes_ingress_v1", namespace, name)
+		ing = &networking.Ingress{
+			ObjectMeta: legacy.ObjectMeta,
+			Spec:       convertIngressV1beta1SpecToV1(legacy.Spec),
+		}
+	} else {
+		log.Printf("[INFO] networking.k8s.io/v1beta1 is not available on this cluster (%v), reading %s/%s through the v1 API instead", err, namespace, name)
+		ing, err = conn.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Ingress %s/%s for migration: %w", namespace, name, err)
+		}
+	}
+
+	if err := d.Set("metadata", flattenMetadataFields(ing.ObjectMeta)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("spec", flattenIngressV1Spec(ing.Spec)); err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// convertIngressV1beta1PathTypeToV1 mirrors the v1 default for the `pathType`
+// field: the v1beta1 schema had no equivalent field, so any rule imported from
+// v1beta1 state is treated as ImplementationSpecific, matching how the
+// apiserver's storage conversion has always defaulted it.
+func convertIngressV1beta1PathTypeToV1() networking.PathType {
+	return networking.PathTypeImplementationSpecific
+}
+
+// convertIngressV1beta1BackendToV1 translates the v1beta1
+// `backend.serviceName`/`backend.servicePort` shape into the v1
+// `backend.service.name`/`backend.service.port` shape used by
+// kubernetes_ingress_v1. servicePort may reference the target port by name or
+// by number, exactly as it could in v1beta1.
+func convertIngressV1beta1BackendToV1(in extensionsv1beta1.IngressBackend) networking.IngressBackend {
+	port := networking.ServiceBackendPort{}
+	switch in.ServicePort.Type {
+	case intstr.String:
+		port.Name = in.ServicePort.StrVal
+	case intstr.Int:
+		port.Number = in.ServicePort.IntVal
+	}
+
+	return networking.IngressBackend{
+		Service: &networking.IngressServiceBackend{
+			Name: in.ServiceName,
+			Port: port,
+		},
+	}
+}
+
+// convertIngressV1beta1RuleToV1 converts a single v1beta1 IngressRule,
+// including its HTTP paths and their backends, into the v1 shape.
+func convertIngressV1beta1RuleToV1(in extensionsv1beta1.IngressRule) networking.IngressRule {
+	out := networking.IngressRule{Host: in.Host}
+	if in.HTTP == nil {
+		return out
+	}
+
+	pathType := convertIngressV1beta1PathTypeToV1()
+	paths := make([]networking.HTTPIngressPath, 0, len(in.HTTP.Paths))
+	for _, p := range in.HTTP.Paths {
+		paths = append(paths, networking.HTTPIngressPath{
+			Path:     p.Path,
+			PathType: &pathType,
+			Backend:  convertIngressV1beta1BackendToV1(p.Backend),
+		})
+	}
+	out.HTTP = &networking.HTTPIngressRuleValue{Paths: paths}
+	return out
+}
+
+// convertIngressV1beta1SpecToV1 converts a full v1beta1 IngressSpec, including
+// its default backend and TLS blocks, into the v1 shape used by
+// kubernetes_ingress_v1. It is called by resourceKubernetesIngressV1Import
+// when the import ID carries the deprecatedIngressV1beta1ImportPrefix.
+func convertIngressV1beta1SpecToV1(in extensionsv1beta1.IngressSpec) networking.IngressSpec {
+	out := networking.IngressSpec{
+		IngressClassName: in.IngressClassName,
+	}
+	if in.Backend != nil {
+		backend := convertIngressV1beta1BackendToV1(*in.Backend)
+		out.DefaultBackend = &backend
+	}
+	for _, rule := range in.Rules {
+		out.Rules = append(out.Rules, convertIngressV1beta1RuleToV1(rule))
+	}
+	for _, tls := range in.TLS {
+		out.TLS = append(out.TLS, networking.IngressTLS{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	networking "k8s.io/api/networking/v1"
+	extensionsv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestConvertIngressV1beta1BackendToV1ByName(t *testing.T) {
+	in := extensionsv1beta1.IngressBackend{
+		ServiceName: "example-service",
+		ServicePort: intstr.FromString("http"),
+	}
+
+	out := convertIngressV1beta1BackendToV1(in)
+	want := networking.IngressBackend{
+		Service: &networking.IngressServiceBackend{
+			Name: "example-service",
+			Port: networking.ServiceBackendPort{Name: "http"},
+		},
+	}
+	if !reflect.DeepEqual(want, out) {
+		t.Errorf("unexpected backend.\nwant: %#v\ngot:  %#v", want, out)
+	}
+}
+
+func TestConvertIngressV1beta1BackendToV1ByNumber(t *testing.T) {
+	in := extensionsv1beta1.IngressBackend{
+		ServiceName: "example-service",
+		ServicePort: intstr.FromInt(8080),
+	}
+
+	out := convertIngressV1beta1BackendToV1(in)
+	want := networking.IngressBackend{
+		Service: &networking.IngressServiceBackend{
+			Name: "example-service",
+			Port: networking.ServiceBackendPort{Number: 8080},
+		},
+	}
+	if !reflect.DeepEqual(want, out) {
+		t.Errorf("unexpected backend.\nwant: %#v\ngot:  %#v", want, out)
+	}
+}
+
+func TestConvertIngressV1beta1SpecToV1(t *testing.T) {
+	className := "nginx"
+	in := extensionsv1beta1.IngressSpec{
+		IngressClassName: &className,
+		Backend: &extensionsv1beta1.IngressBackend{
+			ServiceName: "default-backend",
+			ServicePort: intstr.FromInt(80),
+		},
+		Rules: []extensionsv1beta1.IngressRule{
+			{
+				Host: "example.com",
+				IngressRuleValue: extensionsv1beta1.IngressRuleValue{
+					HTTP: &extensionsv1beta1.HTTPIngressRuleValue{
+						Paths: []extensionsv1beta1.HTTPIngressPath{
+							{
+								Path: "/",
+								Backend: extensionsv1beta1.IngressBackend{
+									ServiceName: "example-service",
+									ServicePort: intstr.FromInt(8080),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		TLS: []extensionsv1beta1.IngressTLS{
+			{Hosts: []string{"example.com"}, SecretName: "example-tls"},
+		},
+	}
+
+	out := convertIngressV1beta1SpecToV1(in)
+
+	if out.IngressClassName == nil || *out.IngressClassName != "nginx" {
+		t.Fatalf("unexpected ingressClassName: %#v", out.IngressClassName)
+	}
+	if out.DefaultBackend == nil || out.DefaultBackend.Service.Name != "default-backend" {
+		t.Fatalf("unexpected default backend: %#v", out.DefaultBackend)
+	}
+	if len(out.Rules) != 1 || out.Rules[0].Host != "example.com" {
+		t.Fatalf("unexpected rules: %#v", out.Rules)
+	}
+	path := out.Rules[0].HTTP.Paths[0]
+	if path.PathType == nil || *path.PathType != networking.PathTypeImplementationSpecific {
+		t.Fatalf("expected path type to default to ImplementationSpecific, got %#v", path.PathType)
+	}
+	if path.Backend.Service.Name != "example-service" || path.Backend.Service.Port.Number != 8080 {
+		t.Fatalf("unexpected path backend: %#v", path.Backend)
+	}
+	if len(out.TLS) != 1 || out.TLS[0].SecretName != "example-tls" {
+		t.Fatalf("unexpected TLS: %#v", out.TLS)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Provider returns the schema.Provider for the resources and data sources
+// defined in this package.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_HOST", ""),
+				Description: "The hostname (in form of URI) of the Kubernetes API. Can be sourced from KUBE_HOST.",
+			},
+			"config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_CONFIG_PATH", ""),
+				Description: "Path to the kube config file. Can be sourced from KUBE_CONFIG_PATH.",
+			},
+			"config_context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_CTX", ""),
+				Description: "Context to use from the kube config file. Can be sourced from KUBE_CTX.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"kubernetes_ingress_v1":             resourceKubernetesIngressV1(),
+			"kubernetes_gateway_v1":             resourceKubernetesGatewayV1(),
+			"kubernetes_gatewayclass_v1":        resourceKubernetesGatewayClassV1(),
+			"kubernetes_httproute_v1":           resourceKubernetesHTTPRouteV1(),
+			"kubernetes_grpcroute_v1":           resourceKubernetesGRPCRouteV1(),
+			"kubernetes_referencegrant_v1beta1": resourceKubernetesReferenceGrantV1beta1(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"kubernetes_ingress_v1":             dataSourceKubernetesIngressV1(),
+			"kubernetes_ingresses_v1":           dataSourceKubernetesIngressesV1(),
+			"kubernetes_gateway_v1":             dataSourceKubernetesGatewayV1(),
+			"kubernetes_gatewayclass_v1":        dataSourceKubernetesGatewayClassV1(),
+			"kubernetes_httproute_v1":           dataSourceKubernetesHTTPRouteV1(),
+			"kubernetes_grpcroute_v1":           dataSourceKubernetesGRPCRouteV1(),
+			"kubernetes_referencegrant_v1beta1": dataSourceKubernetesReferenceGrantV1beta1(),
+		},
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	cfg, err := restConfigFromResourceData(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &kubeClientsets{config: cfg}, nil
+}
+
+func restConfigFromResourceData(d *schema.ResourceData) (*restclient.Config, error) {
+	loader := clientcmd.NewDefaultClientConfigLoadingRules()
+	if v, ok := d.GetOk("config_path"); ok {
+		loader.ExplicitPath = v.(string)
+	}
+
+	overrides := &clientcmd.ConfigOverrides{}
+	if v, ok := d.GetOk("config_context"); ok {
+		overrides.CurrentContext = v.(string)
+	}
+	if v, ok := d.GetOk("host"); ok {
+		overrides.ClusterInfo.Server = v.(string)
+	}
+
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Kubernetes client config: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func resourceKubernetesGatewayV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesGatewayV1Create,
+		ReadContext:   resourceKubernetesGatewayV1Read,
+		UpdateContext: resourceKubernetesGatewayV1Update,
+		DeleteContext: resourceKubernetesGatewayV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("gateway", true),
+			"spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway_class_name": {
+							Type:        schema.TypeString,
+							Description: "GatewayClassName used for this Gateway.",
+							Required:    true,
+						},
+						"listener": {
+							Type:        schema.TypeList,
+							Description: "Listeners associated with this Gateway.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"hostname": {
+										Type:        schema.TypeString,
+										Description: "Hostname specifies the virtual hostname to match for protocol types that define this concept.",
+										Optional:    true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(gatewayv1.HTTPProtocolType),
+											string(gatewayv1.HTTPSProtocolType),
+											string(gatewayv1.TLSProtocolType),
+											string(gatewayv1.TCPProtocolType),
+											string(gatewayv1.UDPProtocolType),
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"listeners": {
+							Type:        schema.TypeList,
+							Description: "Listener status for each listener defined in `spec.listener`.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"attached_routes": {
+										Type:        schema.TypeInt,
+										Description: "The number of routes that have been successfully attached to this listener.",
+										Computed:    true,
+									},
+									"conditions": gatewayConditionsFieldsV1(),
+								},
+							},
+						},
+						"conditions": gatewayConditionsFieldsV1(),
+					},
+				},
+			},
+			"wait_for_programmed": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the Gateway's `Programmed` condition to be `True` before considering the resource created.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceKubernetesGatewayV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	gw := gatewayv1.Gateway{
+		ObjectMeta: metadata,
+		Spec:       expandGatewayV1Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new Gateway: %#v", gw)
+	out, err := conn.GatewayV1().Gateways(metadata.Namespace).Create(ctx, &gw, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new Gateway: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_programmed").(bool) {
+		err = waitGatewayAPICondition(ctx, func(ctx context.Context) ([]metav1.Condition, error) {
+			current, err := conn.GatewayV1().Gateways(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return current.Status.Conditions, nil
+		}, string(gatewayv1.GatewayConditionProgrammed), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gw, err := conn.GatewayV1().Gateways(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] Gateway %s no longer exists, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metadata", flattenMetadataFields(gw.ObjectMeta)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("spec", flattenGatewayV1Spec(gw.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", flattenGatewayV1Status(gw.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGatewayV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gw, err := conn.GatewayV1().Gateways(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	gw.ObjectMeta.Annotations = metadata.Annotations
+	gw.ObjectMeta.Labels = metadata.Labels
+	gw.Spec = expandGatewayV1Spec(d.Get("spec").([]interface{}))
+
+	log.Printf("[INFO] Updating Gateway %s", name)
+	out, err := conn.GatewayV1().Gateways(namespace).Update(ctx, gw, metav1.UpdateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated Gateway: %#v", out)
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Gateway: %s", name)
+	err = conn.GatewayV1().Gateways(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func resourceKubernetesGatewayClassV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesGatewayClassV1Create,
+		ReadContext:   resourceKubernetesGatewayClassV1Read,
+		UpdateContext: resourceKubernetesGatewayClassV1Update,
+		DeleteContext: resourceKubernetesGatewayClassV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("gatewayclass"),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the GatewayClass.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controller_name": {
+							Type:        schema.TypeString,
+							Description: "ControllerName is the name of the controller that is managing Gateways of this class, e.g. `traefik.io/gateway-controller` or `konghq.com/kic-gateway-controller`.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description helps describe a GatewayClass with more details.",
+							Optional:    true,
+						},
+						"parameters_ref": {
+							Type:        schema.TypeList,
+							Description: "ParametersRef is a reference to a resource that contains the configuration parameters corresponding to this GatewayClass.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"kind": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": gatewayConditionsFieldsV1(),
+					},
+				},
+			},
+			"wait_for_accepted": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the GatewayClass's `Accepted` condition to be `True` before considering the resource created.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceKubernetesGatewayClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	gc := gatewayv1.GatewayClass{
+		ObjectMeta: metadata,
+		Spec:       expandGatewayClassV1Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new GatewayClass: %#v", gc)
+	out, err := conn.GatewayV1().GatewayClasses().Create(ctx, &gc, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new GatewayClass: %#v", out)
+	d.SetId(out.Name)
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitGatewayAPICondition(ctx, func(ctx context.Context) ([]metav1.Condition, error) {
+			current, err := conn.GatewayV1().GatewayClasses().Get(ctx, out.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return current.Status.Conditions, nil
+		}, string(gatewayv1.GatewayClassConditionStatusAccepted), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGatewayClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	gc, err := conn.GatewayV1().GatewayClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] GatewayClass %s no longer exists, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metadata", flattenMetadataFields(gc.ObjectMeta)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("spec", flattenGatewayClassV1Spec(gc.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", flattenGatewayAPIConditionsStatus(gc.Status.Conditions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGatewayClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	gc, err := conn.GatewayV1().GatewayClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gc.ObjectMeta.Annotations = expandMetadata(d.Get("metadata").([]interface{})).Annotations
+	gc.ObjectMeta.Labels = expandMetadata(d.Get("metadata").([]interface{})).Labels
+	gc.Spec = expandGatewayClassV1Spec(d.Get("spec").([]interface{}))
+
+	log.Printf("[INFO] Updating GatewayClass %s", name)
+	out, err := conn.GatewayV1().GatewayClasses().Update(ctx, gc, metav1.UpdateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated GatewayClass: %#v", out)
+
+	return resourceKubernetesGatewayClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting GatewayClass: %s", name)
+	err = conn.GatewayV1().GatewayClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
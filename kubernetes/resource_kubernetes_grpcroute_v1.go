@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func resourceKubernetesGRPCRouteV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesGRPCRouteV1Create,
+		ReadContext:   resourceKubernetesGRPCRouteV1Read,
+		UpdateContext: resourceKubernetesGRPCRouteV1Update,
+		DeleteContext: resourceKubernetesGRPCRouteV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("grpcroute", true),
+			"spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parent_ref": gatewayParentRefFieldsV1(false),
+						"hostname": {
+							Type:        schema.TypeList,
+							Description: "Hostnames defines a set of hostnames that should match against the HTTP Host header to select a GRPCRoute used to process the request.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"method": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"service": {
+																Type:        schema.TypeString,
+																Description: "Value of the gRPC service to match against. If left empty or omitted, will match all services.",
+																Optional:    true,
+															},
+															"method": {
+																Type:        schema.TypeString,
+																Description: "Value of the gRPC method to match against. If left empty or omitted, will match all methods.",
+																Optional:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"backend_ref": gatewayBackendRefFieldsV1(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parents": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"controller_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"conditions": gatewayConditionsFieldsV1(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_accepted": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the GRPCRoute's `Accepted` condition to be `True` on every parent it references before considering the resource created.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceKubernetesGRPCRouteV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	route := gatewayv1.GRPCRoute{
+		ObjectMeta: metadata,
+		Spec:       expandGRPCRouteV1Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new GRPCRoute: %#v", route)
+	out, err := conn.GatewayV1().GRPCRoutes(metadata.Namespace).Create(ctx, &route, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new GRPCRoute: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitGatewayAPICondition(ctx, func(ctx context.Context) ([]metav1.Condition, error) {
+			current, err := conn.GatewayV1().GRPCRoutes(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			// Keep waiting until every referenced parent has a status entry.
+			if len(current.Status.Parents) < len(current.Spec.ParentRefs) {
+				return nil, nil
+			}
+			return flattenRouteParentConditions(current.Status.RouteStatus), nil
+		}, string(gatewayv1.RouteConditionAccepted), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGRPCRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGRPCRouteV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	route, err := conn.GatewayV1().GRPCRoutes(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] GRPCRoute %s no longer exists, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metadata", flattenMetadataFields(route.ObjectMeta)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("spec", flattenGRPCRouteV1Spec(route.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", flattenGatewayAPIRouteStatus(route.Status.RouteStatus)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGRPCRouteV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	route, err := conn.GatewayV1().GRPCRoutes(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	route.ObjectMeta.Annotations = metadata.Annotations
+	route.ObjectMeta.Labels = metadata.Labels
+	route.Spec = expandGRPCRouteV1Spec(d.Get("spec").([]interface{}))
+
+	log.Printf("[INFO] Updating GRPCRoute %s", name)
+	out, err := conn.GatewayV1().GRPCRoutes(namespace).Update(ctx, route, metav1.UpdateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated GRPCRoute: %#v", out)
+
+	return resourceKubernetesGRPCRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGRPCRouteV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting GRPCRoute: %s", name)
+	err = conn.GatewayV1().GRPCRoutes(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func resourceKubernetesIngressV1() *schema.Resource {
+	docHTTPIngressPath := networking.HTTPIngressPath{}.SwaggerDoc()
+	docHTTPIngressRuleValue := networking.HTTPIngressPath{}.SwaggerDoc()
+	docIngress := networking.Ingress{}.SwaggerDoc()
+	docIngressTLS := networking.IngressTLS{}.SwaggerDoc()
+	docIngressRule := networking.IngressRule{}.SwaggerDoc()
+	docIngressSpec := networking.IngressSpec{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesIngressV1Create,
+		ReadContext:   resourceKubernetesIngressV1Read,
+		UpdateContext: resourceKubernetesIngressV1Update,
+		DeleteContext: resourceKubernetesIngressV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKubernetesIngressV1Import,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("ingress", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: docIngress["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingress_class_name": {
+							Type:        schema.TypeString,
+							Description: docIngressSpec["ingressClassName"],
+							Optional:    true,
+						},
+						"default_backend": backendSpecFieldsV1(defaultBackendDescriptionV1),
+						"rule": {
+							Type:        schema.TypeList,
+							Description: docIngressSpec["rules"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:        schema.TypeString,
+										Description: docIngressRule["host"],
+										Optional:    true,
+									},
+									"http": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: docIngressRule[""],
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"path": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: docHTTPIngressRuleValue["paths"],
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:        schema.TypeString,
+																Description: docHTTPIngressPath["path"],
+																Optional:    true,
+															},
+															"path_type": {
+																Type:        schema.TypeString,
+																Description: docHTTPIngressPath["pathType"],
+																Optional:    true,
+																Default:     string(networking.PathTypeImplementationSpecific),
+																ValidateFunc: validation.StringInSlice([]string{
+																	string(networking.PathTypeImplementationSpecific),
+																	string(networking.PathTypePrefix),
+																	string(networking.PathTypeExact),
+																}, false),
+															},
+															"backend": backendSpecFieldsV1(ruleBackedDescriptionV1),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"tls": {
+							Type:        schema.TypeList,
+							Description: docIngressSpec["tls"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hosts": {
+										Type:        schema.TypeList,
+										Description: docIngressTLS["hosts"],
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_name": {
+										Type:        schema.TypeString,
+										Description: docIngressTLS["secretName"],
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_balancer": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ingress": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ip": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"hostname": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the load balancer to have at least 1 endpoint (an `ip` or `hostname`) before considering the resource created.",
+				Optional:    true,
+				Default:     false,
+			},
+			"controller_annotations": controllerAnnotationsFieldsV1(false),
+		},
+	}
+}
+
+func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec := expandIngressV1Spec(d.Get("spec").([]interface{}))
+
+	for k, v := range expandControllerAnnotationsV1(d.Get("controller_annotations").([]interface{})) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[k] = v
+	}
+
+	ing := networking.Ingress{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new ingress: %#v", ing)
+	out, err := conn.NetworkingV1().Ingresses(metadata.Namespace).Create(ctx, &ing, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new ingress: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_load_balancer").(bool) {
+		err = waitIngressLoadBalancer(ctx, conn, out.Namespace, out.Name, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesIngressV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading ingress %s", name)
+	ing, err := conn.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] Ingress %s no longer exists, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received ingress: %#v", ing)
+
+	err = d.Set("metadata", flattenMetadataFields(ing.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenIngressV1Spec(ing.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", []interface{}{
+		map[string][]interface{}{
+			"load_balancer": flattenIngressV1Status(ing.Status.LoadBalancer),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("controller_annotations", flattenControllerAnnotationsV1(ing.ObjectMeta.Annotations))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ing, err := conn.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	for k, v := range expandControllerAnnotationsV1(d.Get("controller_annotations").([]interface{})) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[k] = v
+	}
+
+	ing.ObjectMeta.Annotations = metadata.Annotations
+	ing.ObjectMeta.Labels = metadata.Labels
+	ing.Spec = expandIngressV1Spec(d.Get("spec").([]interface{}))
+
+	log.Printf("[INFO] Updating ingress %s: %#v", name, ing)
+	out, err := conn.NetworkingV1().Ingresses(namespace).Update(ctx, ing, metav1.UpdateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated ingress: %#v", out)
+
+	if d.Get("wait_for_load_balancer").(bool) {
+		err = waitIngressLoadBalancer(ctx, conn, out.Namespace, out.Name, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesIngressV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesIngressV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting ingress: %#v", name)
+	err = conn.NetworkingV1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Ingress %s deleted", name)
+	d.SetId("")
+
+	return nil
+}
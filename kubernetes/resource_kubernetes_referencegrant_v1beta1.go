@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func resourceKubernetesReferenceGrantV1beta1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesReferenceGrantV1beta1Create,
+		ReadContext:   resourceKubernetesReferenceGrantV1beta1Read,
+		UpdateContext: resourceKubernetesReferenceGrantV1beta1Update,
+		DeleteContext: resourceKubernetesReferenceGrantV1beta1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("referencegrant", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the ReferenceGrant.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeList,
+							Description: "From describes the trusted namespaces and kinds that can reference the resources described in `to`.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"kind": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"to": {
+							Type:        schema.TypeList,
+							Description: "To describes the resources that may be referenced by the resources described in `from`.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"kind": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name is the name of the referent, restricting the grant to a single resource. Leave empty to allow any resource of the given kind.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesReferenceGrantV1beta1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	rg := gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metadata,
+		Spec:       expandReferenceGrantV1beta1Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new ReferenceGrant: %#v", rg)
+	out, err := conn.GatewayV1beta1().ReferenceGrants(metadata.Namespace).Create(ctx, &rg, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new ReferenceGrant: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesReferenceGrantV1beta1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReferenceGrantV1beta1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rg, err := conn.GatewayV1beta1().ReferenceGrants(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] ReferenceGrant %s no longer exists, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metadata", flattenMetadataFields(rg.ObjectMeta)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("spec", flattenReferenceGrantV1beta1Spec(rg.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesReferenceGrantV1beta1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rg, err := conn.GatewayV1beta1().ReferenceGrants(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	rg.ObjectMeta.Annotations = metadata.Annotations
+	rg.ObjectMeta.Labels = metadata.Labels
+	rg.Spec = expandReferenceGrantV1beta1Spec(d.Get("spec").([]interface{}))
+
+	log.Printf("[INFO] Updating ReferenceGrant %s", name)
+	out, err := conn.GatewayV1beta1().ReferenceGrants(namespace).Update(ctx, rg, metav1.UpdateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted updated ReferenceGrant: %#v", out)
+
+	return resourceKubernetesReferenceGrantV1beta1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReferenceGrantV1beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).GatewayClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting ReferenceGrant: %s", name)
+	err = conn.GatewayV1beta1().ReferenceGrants(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Shared schema builders for the Gateway API (gateway.networking.k8s.io) resources
+// and data sources. The `gateway-api` clientset used by these resources is
+// constructed alongside the other typed clientsets on KubeClientsets; see
+// meta.(KubeClientsets).GatewayClientset() in config.go.
+
+// gatewayParentRefFieldsV1 describes a ParentReference, used by routes to select
+// the Gateways (or other parent resources) they attach to. Pass computed=true to
+// build the read-only shape used by data sources.
+func gatewayParentRefFieldsV1(computed bool) *schema.Schema {
+	group := &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Group is the group of the referent. Defaults to `gateway.networking.k8s.io`.",
+		Optional:    !computed,
+		Computed:    true,
+	}
+	kind := &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Kind is the kind of the referent. Defaults to `Gateway`.",
+		Optional:    !computed,
+		Computed:    true,
+	}
+	if !computed {
+		group.Default = "gateway.networking.k8s.io"
+		kind.Default = "Gateway"
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "ParentRefs references the resources (usually Gateways) that a route wants to be attached to.",
+		Optional:    !computed,
+		Computed:    computed,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group": group,
+				"kind":  kind,
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: "Namespace is the namespace of the referent. Defaults to the local namespace of the route.",
+					Optional:    !computed,
+					Computed:    computed,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name is the name of the referent.",
+					Required:    !computed,
+					Computed:    computed,
+				},
+				"section_name": {
+					Type:        schema.TypeString,
+					Description: "SectionName is the name of a section within the target resource, e.g. a Listener name within a Gateway.",
+					Optional:    true,
+					Computed:    computed,
+				},
+				"port": {
+					Type:        schema.TypeInt,
+					Description: "Port is the port the referent listens on, when it can have more than one.",
+					Optional:    true,
+					Computed:    computed,
+				},
+			},
+		},
+	}
+}
+
+// gatewayBackendRefFieldsV1 describes a BackendRef, used by route rules to
+// reference the Services (or other backends) that should receive traffic, with
+// an optional weight for traffic splitting.
+func gatewayBackendRefFieldsV1(computed bool) *schema.Schema {
+	weight := &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Weight specifies the proportion of requests forwarded to this backend, relative to the sum of all weights in this BackendRefs list. Defaults to 1.",
+		Optional:    !computed,
+		Computed:    computed,
+	}
+	if !computed {
+		weight.Default = 1
+		weight.ValidateFunc = validation.IntAtLeast(0)
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "BackendRefs defines the backends where matching requests should be sent.",
+		Optional:    !computed,
+		Computed:    computed,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group": {
+					Type:        schema.TypeString,
+					Description: "Group is the group of the referent. Defaults to the core API group for Service backends.",
+					Optional:    true,
+					Computed:    computed,
+				},
+				"kind": {
+					Type:        schema.TypeString,
+					Description: "Kind is the kind of the referent. Defaults to `Service`.",
+					Optional:    true,
+					Computed:    computed,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name is the name of the referent.",
+					Required:    !computed,
+					Computed:    computed,
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: "Namespace is the namespace of the referent. Defaults to the local namespace of the route.",
+					Optional:    true,
+					Computed:    computed,
+				},
+				"port": {
+					Type:        schema.TypeInt,
+					Description: "Port is the port of the referent.",
+					Optional:    true,
+					Computed:    computed,
+				},
+				"weight": weight,
+			},
+		},
+	}
+}
+
+// gatewayConditionsFieldsV1 is the computed-only `status.conditions` shape shared
+// by every Gateway API resource (Gateway, GatewayClass, and the route kinds all
+// surface standard `metav1.Condition` entries such as `Accepted`/`Programmed`).
+func gatewayConditionsFieldsV1() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Conditions describe the current state of the resource as observed by the controller(s), e.g. `Accepted` or `Programmed`.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reason": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
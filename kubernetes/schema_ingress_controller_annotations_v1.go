@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Well-known annotations recognized by popular ingress controllers. These are
+// surfaced as a typed `controller_annotations` block on kubernetes_ingress_v1 so
+// Terraform can validate and diff them instead of treating them as opaque
+// strings in `metadata.annotations`.
+const (
+	annotationTraefikRuleType    = "traefik.ingress.kubernetes.io/rule-type"
+	annotationTraefikEntrypoints = "traefik.ingress.kubernetes.io/router.entrypoints"
+	annotationNginxRewriteTarget = "nginx.ingress.kubernetes.io/rewrite-target"
+	annotationNginxSSLRedirect   = "nginx.ingress.kubernetes.io/ssl-redirect"
+	annotationNginxProxyBodySize = "nginx.ingress.kubernetes.io/proxy-body-size"
+	annotationKongPlugins        = "konghq.com/plugins"
+	annotationKongStripPath      = "konghq.com/strip-path"
+)
+
+// traefikRuleTypes are the rule types Traefik's Kubernetes Ingress provider
+// accepts in the `traefik.ingress.kubernetes.io/rule-type` annotation.
+var traefikRuleTypes = []string{
+	"Path",
+	"PathPrefix",
+	"PathStrip",
+	"PathPrefixStrip",
+	"ReplacePath",
+	"ReplacePathRegex",
+	"AddPrefix",
+}
+
+// controllerAnnotationsFieldsV1 builds the `controller_annotations` block.
+// Pass computed=true to build the read-only shape used by the data source.
+func controllerAnnotationsFieldsV1(computed bool) *schema.Schema {
+	ruleType := &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Value for the `traefik.ingress.kubernetes.io/rule-type` annotation.",
+		Optional:    !computed,
+		Computed:    computed,
+	}
+	if !computed {
+		ruleType.ValidateFunc = validation.StringInSlice(traefikRuleTypes, false)
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Typed, validated access to well-known ingress controller annotations. Values set here are merged into `metadata.annotations`.",
+		Optional:    !computed,
+		Computed:    computed,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"traefik": {
+					Type:        schema.TypeList,
+					Description: "Annotations recognized by the Traefik ingress controller.",
+					Optional:    !computed,
+					Computed:    computed,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"rule_type": ruleType,
+							"entrypoints": {
+								Type:        schema.TypeList,
+								Description: "Value for the `traefik.ingress.kubernetes.io/router.entrypoints` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"nginx": {
+					Type:        schema.TypeList,
+					Description: "Annotations recognized by the ingress-nginx controller.",
+					Optional:    !computed,
+					Computed:    computed,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"rewrite_target": {
+								Type:        schema.TypeString,
+								Description: "Value for the `nginx.ingress.kubernetes.io/rewrite-target` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+							},
+							"ssl_redirect": {
+								Type:        schema.TypeBool,
+								Description: "Value for the `nginx.ingress.kubernetes.io/ssl-redirect` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+							},
+							"proxy_body_size": {
+								Type:        schema.TypeString,
+								Description: "Value for the `nginx.ingress.kubernetes.io/proxy-body-size` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+							},
+						},
+					},
+				},
+				"kong": {
+					Type:        schema.TypeList,
+					Description: "Annotations recognized by the Kong ingress controller.",
+					Optional:    !computed,
+					Computed:    computed,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"plugins": {
+								Type:        schema.TypeList,
+								Description: "Value for the `konghq.com/plugins` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"strip_path": {
+								Type:        schema.TypeBool,
+								Description: "Value for the `konghq.com/strip-path` annotation.",
+								Optional:    !computed,
+								Computed:    computed,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandControllerAnnotationsV1 turns a `controller_annotations` block into the
+// raw annotation key/value pairs it represents, to be merged into
+// metadata.annotations before the Ingress is created or updated.
+func expandControllerAnnotationsV1(in []interface{}) map[string]string {
+	out := map[string]string{}
+	if len(in) == 0 || in[0] == nil {
+		return out
+	}
+	m := in[0].(map[string]interface{})
+
+	if traefik, ok := m["traefik"].([]interface{}); ok && len(traefik) > 0 && traefik[0] != nil {
+		t := traefik[0].(map[string]interface{})
+		if v, ok := t["rule_type"].(string); ok && v != "" {
+			out[annotationTraefikRuleType] = v
+		}
+		if entrypoints, ok := t["entrypoints"].([]interface{}); ok && len(entrypoints) > 0 {
+			values := make([]string, 0, len(entrypoints))
+			for _, e := range entrypoints {
+				values = append(values, e.(string))
+			}
+			out[annotationTraefikEntrypoints] = strings.Join(values, ",")
+		}
+	}
+
+	if nginx, ok := m["nginx"].([]interface{}); ok && len(nginx) > 0 && nginx[0] != nil {
+		n := nginx[0].(map[string]interface{})
+		if v, ok := n["rewrite_target"].(string); ok && v != "" {
+			out[annotationNginxRewriteTarget] = v
+		}
+		if v, ok := n["ssl_redirect"].(bool); ok && v {
+			out[annotationNginxSSLRedirect] = strconv.FormatBool(v)
+		}
+		if v, ok := n["proxy_body_size"].(string); ok && v != "" {
+			out[annotationNginxProxyBodySize] = v
+		}
+	}
+
+	if kong, ok := m["kong"].([]interface{}); ok && len(kong) > 0 && kong[0] != nil {
+		k := kong[0].(map[string]interface{})
+		if plugins, ok := k["plugins"].([]interface{}); ok && len(plugins) > 0 {
+			values := make([]string, 0, len(plugins))
+			for _, p := range plugins {
+				values = append(values, p.(string))
+			}
+			out[annotationKongPlugins] = strings.Join(values, ",")
+		}
+		if v, ok := k["strip_path"].(bool); ok && v {
+			out[annotationKongStripPath] = strconv.FormatBool(v)
+		}
+	}
+
+	return out
+}
+
+// flattenControllerAnnotationsV1 recognizes well-known ingress controller
+// annotations on the given Ingress and surfaces them as a typed
+// `controller_annotations` block, so `terraform plan` produces a meaningful
+// diff instead of an opaque string change in `metadata.annotations`. It returns
+// an empty list if none of the recognized annotations are present.
+func flattenControllerAnnotationsV1(annotations map[string]string) []interface{} {
+	splitList := func(v string) []interface{} {
+		parts := strings.Split(v, ",")
+		out := make([]interface{}, 0, len(parts))
+		for _, p := range parts {
+			out = append(out, p)
+		}
+		return out
+	}
+
+	traefik := map[string]interface{}{}
+	if v, ok := annotations[annotationTraefikRuleType]; ok {
+		traefik["rule_type"] = v
+	}
+	if v, ok := annotations[annotationTraefikEntrypoints]; ok {
+		traefik["entrypoints"] = splitList(v)
+	}
+
+	nginx := map[string]interface{}{}
+	if v, ok := annotations[annotationNginxRewriteTarget]; ok {
+		nginx["rewrite_target"] = v
+	}
+	if v, ok := annotations[annotationNginxSSLRedirect]; ok {
+		nginx["ssl_redirect"], _ = strconv.ParseBool(v)
+	}
+	if v, ok := annotations[annotationNginxProxyBodySize]; ok {
+		nginx["proxy_body_size"] = v
+	}
+
+	kong := map[string]interface{}{}
+	if v, ok := annotations[annotationKongPlugins]; ok {
+		kong["plugins"] = splitList(v)
+	}
+	if v, ok := annotations[annotationKongStripPath]; ok {
+		kong["strip_path"], _ = strconv.ParseBool(v)
+	}
+
+	if len(traefik) == 0 && len(nginx) == 0 && len(kong) == 0 {
+		return []interface{}{}
+	}
+
+	out := map[string]interface{}{}
+	if len(traefik) > 0 {
+		out["traefik"] = []interface{}{traefik}
+	}
+	if len(nginx) > 0 {
+		out["nginx"] = []interface{}{nginx}
+	}
+	if len(kong) > 0 {
+		out["kong"] = []interface{}{kong}
+	}
+
+	return []interface{}{out}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestControllerAnnotationsV1RoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"traefik": []interface{}{
+				map[string]interface{}{
+					"rule_type":   "PathPrefix",
+					"entrypoints": []interface{}{"web", "websecure"},
+				},
+			},
+			"nginx": []interface{}{
+				map[string]interface{}{
+					"rewrite_target":  "/$1",
+					"ssl_redirect":    true,
+					"proxy_body_size": "10m",
+				},
+			},
+			"kong": []interface{}{
+				map[string]interface{}{
+					"plugins":    []interface{}{"rate-limiting", "key-auth"},
+					"strip_path": true,
+				},
+			},
+		},
+	}
+
+	annotations := expandControllerAnnotationsV1(in)
+	want := map[string]string{
+		annotationTraefikRuleType:    "PathPrefix",
+		annotationTraefikEntrypoints: "web,websecure",
+		annotationNginxRewriteTarget: "/$1",
+		annotationNginxSSLRedirect:   "true",
+		annotationNginxProxyBodySize: "10m",
+		annotationKongPlugins:        "rate-limiting,key-auth",
+		annotationKongStripPath:      "true",
+	}
+	if !reflect.DeepEqual(want, annotations) {
+		t.Fatalf("unexpected annotations.\nwant: %#v\ngot:  %#v", want, annotations)
+	}
+
+	out := flattenControllerAnnotationsV1(annotations)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestExpandControllerAnnotationsV1Empty(t *testing.T) {
+	if annotations := expandControllerAnnotationsV1(nil); len(annotations) != 0 {
+		t.Errorf("expected no annotations, got %#v", annotations)
+	}
+}
+
+func TestFlattenControllerAnnotationsV1NoRecognizedAnnotations(t *testing.T) {
+	out := flattenControllerAnnotationsV1(map[string]string{"unrelated.example.com/foo": "bar"})
+	want := []interface{}{}
+	if !reflect.DeepEqual(want, out) {
+		t.Errorf("expected an empty list, got %#v", out)
+	}
+}
@@ -0,0 +1,526 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// --- conditions (shared by GatewayClass, Gateway and the route status.parents) ---
+
+func flattenGatewayAPIConditions(in []metav1.Condition) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, c := range in {
+		out = append(out, map[string]interface{}{
+			"type":    c.Type,
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		})
+	}
+	return out
+}
+
+func flattenGatewayAPIConditionsStatus(in []metav1.Condition) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"conditions": flattenGatewayAPIConditions(in),
+		},
+	}
+}
+
+// --- parentRefs / backendRefs (shared by HTTPRoute and GRPCRoute) ---
+
+func expandGatewayAPIParentRefs(in []interface{}) []gatewayv1.ParentReference {
+	out := make([]gatewayv1.ParentReference, 0, len(in))
+	for _, raw := range in {
+		m := raw.(map[string]interface{})
+		ref := gatewayv1.ParentReference{
+			Name: gatewayv1.ObjectName(m["name"].(string)),
+		}
+		if v, ok := m["group"].(string); ok && v != "" {
+			g := gatewayv1.Group(v)
+			ref.Group = &g
+		}
+		if v, ok := m["kind"].(string); ok && v != "" {
+			k := gatewayv1.Kind(v)
+			ref.Kind = &k
+		}
+		if v, ok := m["namespace"].(string); ok && v != "" {
+			ns := gatewayv1.Namespace(v)
+			ref.Namespace = &ns
+		}
+		if v, ok := m["section_name"].(string); ok && v != "" {
+			sn := gatewayv1.SectionName(v)
+			ref.SectionName = &sn
+		}
+		if v, ok := m["port"].(int); ok && v != 0 {
+			p := gatewayv1.PortNumber(v)
+			ref.Port = &p
+		}
+		out = append(out, ref)
+	}
+	return out
+}
+
+func flattenGatewayAPIParentRefs(in []gatewayv1.ParentReference) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, ref := range in {
+		m := map[string]interface{}{
+			"name": string(ref.Name),
+		}
+		if ref.Group != nil {
+			m["group"] = string(*ref.Group)
+		}
+		if ref.Kind != nil {
+			m["kind"] = string(*ref.Kind)
+		}
+		if ref.Namespace != nil {
+			m["namespace"] = string(*ref.Namespace)
+		}
+		if ref.SectionName != nil {
+			m["section_name"] = string(*ref.SectionName)
+		}
+		if ref.Port != nil {
+			m["port"] = int(*ref.Port)
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func expandGatewayAPIBackendRefs(in []interface{}) []gatewayv1.HTTPBackendRef {
+	out := make([]gatewayv1.HTTPBackendRef, 0, len(in))
+	for _, raw := range in {
+		m := raw.(map[string]interface{})
+		ref := gatewayv1.HTTPBackendRef{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(m["name"].(string)),
+				},
+			},
+		}
+		if v, ok := m["group"].(string); ok && v != "" {
+			g := gatewayv1.Group(v)
+			ref.Group = &g
+		}
+		if v, ok := m["kind"].(string); ok && v != "" {
+			k := gatewayv1.Kind(v)
+			ref.Kind = &k
+		}
+		if v, ok := m["namespace"].(string); ok && v != "" {
+			ns := gatewayv1.Namespace(v)
+			ref.Namespace = &ns
+		}
+		if v, ok := m["port"].(int); ok && v != 0 {
+			p := gatewayv1.PortNumber(v)
+			ref.Port = &p
+		}
+		if v, ok := m["weight"].(int); ok {
+			w := int32(v)
+			ref.Weight = &w
+		}
+		out = append(out, ref)
+	}
+	return out
+}
+
+func flattenGatewayAPIBackendRefs(in []gatewayv1.HTTPBackendRef) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, ref := range in {
+		m := map[string]interface{}{
+			"name": string(ref.Name),
+		}
+		if ref.Group != nil {
+			m["group"] = string(*ref.Group)
+		}
+		if ref.Kind != nil {
+			m["kind"] = string(*ref.Kind)
+		}
+		if ref.Namespace != nil {
+			m["namespace"] = string(*ref.Namespace)
+		}
+		if ref.Port != nil {
+			m["port"] = int(*ref.Port)
+		}
+		if ref.Weight != nil {
+			m["weight"] = int(*ref.Weight)
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// --- GatewayClass ---
+
+func expandGatewayClassV1Spec(in []interface{}) gatewayv1.GatewayClassSpec {
+	if len(in) == 0 || in[0] == nil {
+		return gatewayv1.GatewayClassSpec{}
+	}
+	m := in[0].(map[string]interface{})
+
+	spec := gatewayv1.GatewayClassSpec{
+		ControllerName: gatewayv1.GatewayController(m["controller_name"].(string)),
+	}
+	if v, ok := m["description"].(string); ok && v != "" {
+		spec.Description = &v
+	}
+	if refs, ok := m["parameters_ref"].([]interface{}); ok && len(refs) > 0 {
+		r := refs[0].(map[string]interface{})
+		spec.ParametersRef = &gatewayv1.ParametersReference{
+			Group: gatewayv1.Group(r["group"].(string)),
+			Kind:  gatewayv1.Kind(r["kind"].(string)),
+			Name:  r["name"].(string),
+		}
+		if ns, ok := r["namespace"].(string); ok && ns != "" {
+			n := gatewayv1.Namespace(ns)
+			spec.ParametersRef.Namespace = &n
+		}
+	}
+	return spec
+}
+
+func flattenGatewayClassV1Spec(in gatewayv1.GatewayClassSpec) []interface{} {
+	m := map[string]interface{}{
+		"controller_name": string(in.ControllerName),
+	}
+	if in.Description != nil {
+		m["description"] = *in.Description
+	}
+	if in.ParametersRef != nil {
+		r := map[string]interface{}{
+			"group": string(in.ParametersRef.Group),
+			"kind":  string(in.ParametersRef.Kind),
+			"name":  in.ParametersRef.Name,
+		}
+		if in.ParametersRef.Namespace != nil {
+			r["namespace"] = string(*in.ParametersRef.Namespace)
+		}
+		m["parameters_ref"] = []interface{}{r}
+	}
+	return []interface{}{m}
+}
+
+// --- Gateway ---
+
+func expandGatewayV1Spec(in []interface{}) gatewayv1.GatewaySpec {
+	if len(in) == 0 || in[0] == nil {
+		return gatewayv1.GatewaySpec{}
+	}
+	m := in[0].(map[string]interface{})
+
+	spec := gatewayv1.GatewaySpec{
+		GatewayClassName: gatewayv1.ObjectName(m["gateway_class_name"].(string)),
+	}
+	for _, raw := range m["listener"].([]interface{}) {
+		l := raw.(map[string]interface{})
+		listener := gatewayv1.Listener{
+			Name:     gatewayv1.SectionName(l["name"].(string)),
+			Port:     gatewayv1.PortNumber(l["port"].(int)),
+			Protocol: gatewayv1.ProtocolType(l["protocol"].(string)),
+		}
+		if h, ok := l["hostname"].(string); ok && h != "" {
+			hn := gatewayv1.Hostname(h)
+			listener.Hostname = &hn
+		}
+		spec.Listeners = append(spec.Listeners, listener)
+	}
+	return spec
+}
+
+func flattenGatewayV1Spec(in gatewayv1.GatewaySpec) []interface{} {
+	listeners := make([]interface{}, 0, len(in.Listeners))
+	for _, l := range in.Listeners {
+		lm := map[string]interface{}{
+			"name":     string(l.Name),
+			"port":     int(l.Port),
+			"protocol": string(l.Protocol),
+		}
+		if l.Hostname != nil {
+			lm["hostname"] = string(*l.Hostname)
+		}
+		listeners = append(listeners, lm)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"gateway_class_name": string(in.GatewayClassName),
+			"listener":           listeners,
+		},
+	}
+}
+
+func flattenGatewayV1Status(in gatewayv1.GatewayStatus) []interface{} {
+	addresses := make([]interface{}, 0, len(in.Addresses))
+	for _, a := range in.Addresses {
+		addresses = append(addresses, map[string]interface{}{
+			"value": a.Value,
+		})
+	}
+
+	listeners := make([]interface{}, 0, len(in.Listeners))
+	for _, l := range in.Listeners {
+		listeners = append(listeners, map[string]interface{}{
+			"name":            string(l.Name),
+			"attached_routes": int(l.AttachedRoutes),
+			"conditions":      flattenGatewayAPIConditions(l.Conditions),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"addresses":  addresses,
+			"listeners":  listeners,
+			"conditions": flattenGatewayAPIConditions(in.Conditions),
+		},
+	}
+}
+
+// --- route status (shared by HTTPRoute and GRPCRoute: status.parents[].conditions) ---
+
+func flattenGatewayAPIRouteStatus(in gatewayv1.RouteStatus) []interface{} {
+	parents := make([]interface{}, 0, len(in.Parents))
+	for _, p := range in.Parents {
+		parents = append(parents, map[string]interface{}{
+			"controller_name": string(p.ControllerName),
+			"conditions":      flattenGatewayAPIConditions(p.Conditions),
+		})
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"parents": parents,
+		},
+	}
+}
+
+// --- HTTPRoute ---
+
+func expandHTTPRouteV1Spec(in []interface{}) gatewayv1.HTTPRouteSpec {
+	if len(in) == 0 || in[0] == nil {
+		return gatewayv1.HTTPRouteSpec{}
+	}
+	m := in[0].(map[string]interface{})
+
+	spec := gatewayv1.HTTPRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: expandGatewayAPIParentRefs(m["parent_ref"].([]interface{})),
+		},
+	}
+	for _, h := range m["hostname"].([]interface{}) {
+		spec.Hostnames = append(spec.Hostnames, gatewayv1.Hostname(h.(string)))
+	}
+	for _, raw := range m["rule"].([]interface{}) {
+		r := raw.(map[string]interface{})
+		rule := gatewayv1.HTTPRouteRule{
+			BackendRefs: expandGatewayAPIBackendRefs(r["backend_ref"].([]interface{})),
+		}
+		for _, rawMatch := range r["match"].([]interface{}) {
+			match := rawMatch.(map[string]interface{})
+			httpMatch := gatewayv1.HTTPRouteMatch{}
+			if path, ok := match["path"].([]interface{}); ok && len(path) > 0 {
+				p := path[0].(map[string]interface{})
+				pathType := gatewayv1.PathMatchType(p["type"].(string))
+				pathValue := p["value"].(string)
+				httpMatch.Path = &gatewayv1.HTTPPathMatch{
+					Type:  &pathType,
+					Value: &pathValue,
+				}
+			}
+			rule.Matches = append(rule.Matches, httpMatch)
+		}
+		spec.Rules = append(spec.Rules, rule)
+	}
+	return spec
+}
+
+func flattenHTTPRouteV1Spec(in gatewayv1.HTTPRouteSpec) []interface{} {
+	hostnames := make([]interface{}, 0, len(in.Hostnames))
+	for _, h := range in.Hostnames {
+		hostnames = append(hostnames, string(h))
+	}
+
+	rules := make([]interface{}, 0, len(in.Rules))
+	for _, r := range in.Rules {
+		matches := make([]interface{}, 0, len(r.Matches))
+		for _, match := range r.Matches {
+			matchM := map[string]interface{}{}
+			if match.Path != nil {
+				pm := map[string]interface{}{}
+				if match.Path.Type != nil {
+					pm["type"] = string(*match.Path.Type)
+				}
+				if match.Path.Value != nil {
+					pm["value"] = *match.Path.Value
+				}
+				matchM["path"] = []interface{}{pm}
+			}
+			matches = append(matches, matchM)
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"match":       matches,
+			"backend_ref": flattenGatewayAPIBackendRefs(r.BackendRefs),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"parent_ref": flattenGatewayAPIParentRefs(in.ParentRefs),
+			"hostname":   hostnames,
+			"rule":       rules,
+		},
+	}
+}
+
+// --- GRPCRoute ---
+
+func expandGRPCRouteV1Spec(in []interface{}) gatewayv1.GRPCRouteSpec {
+	if len(in) == 0 || in[0] == nil {
+		return gatewayv1.GRPCRouteSpec{}
+	}
+	m := in[0].(map[string]interface{})
+
+	spec := gatewayv1.GRPCRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: expandGatewayAPIParentRefs(m["parent_ref"].([]interface{})),
+		},
+	}
+	for _, h := range m["hostname"].([]interface{}) {
+		spec.Hostnames = append(spec.Hostnames, gatewayv1.Hostname(h.(string)))
+	}
+	for _, raw := range m["rule"].([]interface{}) {
+		r := raw.(map[string]interface{})
+		rule := gatewayv1.GRPCRouteRule{}
+		for _, rawBackend := range r["backend_ref"].([]interface{}) {
+			httpRefs := expandGatewayAPIBackendRefs([]interface{}{rawBackend})
+			if len(httpRefs) > 0 {
+				rule.BackendRefs = append(rule.BackendRefs, gatewayv1.GRPCBackendRef{BackendRef: httpRefs[0].BackendRef})
+			}
+		}
+		for _, rawMatch := range r["match"].([]interface{}) {
+			match := rawMatch.(map[string]interface{})
+			grpcMatch := gatewayv1.GRPCRouteMatch{}
+			if m2, ok := match["method"].([]interface{}); ok && len(m2) > 0 {
+				mm := m2[0].(map[string]interface{})
+				service := mm["service"].(string)
+				method := mm["method"].(string)
+				grpcMatch.Method = &gatewayv1.GRPCMethodMatch{
+					Service: &service,
+					Method:  &method,
+				}
+			}
+			rule.Matches = append(rule.Matches, grpcMatch)
+		}
+		spec.Rules = append(spec.Rules, rule)
+	}
+	return spec
+}
+
+func flattenGRPCRouteV1Spec(in gatewayv1.GRPCRouteSpec) []interface{} {
+	hostnames := make([]interface{}, 0, len(in.Hostnames))
+	for _, h := range in.Hostnames {
+		hostnames = append(hostnames, string(h))
+	}
+
+	rules := make([]interface{}, 0, len(in.Rules))
+	for _, r := range in.Rules {
+		matches := make([]interface{}, 0, len(r.Matches))
+		for _, match := range r.Matches {
+			matchM := map[string]interface{}{}
+			if match.Method != nil {
+				mm := map[string]interface{}{}
+				if match.Method.Service != nil {
+					mm["service"] = *match.Method.Service
+				}
+				if match.Method.Method != nil {
+					mm["method"] = *match.Method.Method
+				}
+				matchM["method"] = []interface{}{mm}
+			}
+			matches = append(matches, matchM)
+		}
+
+		backendRefs := make([]interface{}, 0, len(r.BackendRefs))
+		for _, b := range r.BackendRefs {
+			backendRefs = append(backendRefs, flattenGatewayAPIBackendRefs([]gatewayv1.HTTPBackendRef{{BackendRef: b.BackendRef}})[0])
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"match":       matches,
+			"backend_ref": backendRefs,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"parent_ref": flattenGatewayAPIParentRefs(in.ParentRefs),
+			"hostname":   hostnames,
+			"rule":       rules,
+		},
+	}
+}
+
+// --- ReferenceGrant ---
+
+func expandReferenceGrantV1beta1Spec(in []interface{}) gatewayv1beta1.ReferenceGrantSpec {
+	if len(in) == 0 || in[0] == nil {
+		return gatewayv1beta1.ReferenceGrantSpec{}
+	}
+	m := in[0].(map[string]interface{})
+
+	spec := gatewayv1beta1.ReferenceGrantSpec{}
+	for _, raw := range m["from"].([]interface{}) {
+		f := raw.(map[string]interface{})
+		spec.From = append(spec.From, gatewayv1beta1.ReferenceGrantFrom{
+			Group:     gatewayv1beta1.Group(f["group"].(string)),
+			Kind:      gatewayv1beta1.Kind(f["kind"].(string)),
+			Namespace: gatewayv1beta1.Namespace(f["namespace"].(string)),
+		})
+	}
+	for _, raw := range m["to"].([]interface{}) {
+		t := raw.(map[string]interface{})
+		to := gatewayv1beta1.ReferenceGrantTo{
+			Group: gatewayv1beta1.Group(t["group"].(string)),
+			Kind:  gatewayv1beta1.Kind(t["kind"].(string)),
+		}
+		if name, ok := t["name"].(string); ok && name != "" {
+			n := gatewayv1beta1.ObjectName(name)
+			to.Name = &n
+		}
+		spec.To = append(spec.To, to)
+	}
+	return spec
+}
+
+func flattenReferenceGrantV1beta1Spec(in gatewayv1beta1.ReferenceGrantSpec) []interface{} {
+	from := make([]interface{}, 0, len(in.From))
+	for _, f := range in.From {
+		from = append(from, map[string]interface{}{
+			"group":     string(f.Group),
+			"kind":      string(f.Kind),
+			"namespace": string(f.Namespace),
+		})
+	}
+
+	to := make([]interface{}, 0, len(in.To))
+	for _, t := range in.To {
+		tm := map[string]interface{}{
+			"group": string(t.Group),
+			"kind":  string(t.Kind),
+		}
+		if t.Name != nil {
+			tm["name"] = string(*t.Name)
+		}
+		to = append(to, tm)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"from": from,
+			"to":   to,
+		},
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGatewayClassV1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"controller_name": "example.com/gateway-controller",
+			"description":     "test gatewayclass",
+			"parameters_ref": []interface{}{
+				map[string]interface{}{
+					"group":     "example.com",
+					"kind":      "Config",
+					"name":      "config",
+					"namespace": "default",
+				},
+			},
+		},
+	}
+
+	spec := expandGatewayClassV1Spec(in)
+	if spec.ControllerName != "example.com/gateway-controller" {
+		t.Errorf("unexpected controller name: %s", spec.ControllerName)
+	}
+	if spec.ParametersRef == nil || spec.ParametersRef.Name != "config" {
+		t.Fatalf("expected parameters_ref to be expanded, got %#v", spec.ParametersRef)
+	}
+
+	out := flattenGatewayClassV1Spec(spec)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestGatewayV1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"gateway_class_name": "example-class",
+			"listener": []interface{}{
+				map[string]interface{}{
+					"name":     "http",
+					"hostname": "example.com",
+					"port":     80,
+					"protocol": "HTTP",
+				},
+			},
+		},
+	}
+
+	spec := expandGatewayV1Spec(in)
+	if len(spec.Listeners) != 1 || spec.Listeners[0].Port != 80 {
+		t.Fatalf("unexpected listeners: %#v", spec.Listeners)
+	}
+
+	out := flattenGatewayV1Spec(spec)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestHTTPRouteV1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"parent_ref": []interface{}{
+				map[string]interface{}{
+					"name": "example-gateway",
+				},
+			},
+			"hostname": []interface{}{"example.com"},
+			"rule": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{
+							"path": []interface{}{
+								map[string]interface{}{
+									"type":  "PathPrefix",
+									"value": "/",
+								},
+							},
+						},
+					},
+					"backend_ref": []interface{}{
+						map[string]interface{}{
+							"name": "example-service",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	spec := expandHTTPRouteV1Spec(in)
+	if len(spec.Rules) != 1 || len(spec.Rules[0].BackendRefs) != 1 {
+		t.Fatalf("unexpected rules: %#v", spec.Rules)
+	}
+
+	out := flattenHTTPRouteV1Spec(spec)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestGRPCRouteV1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"parent_ref": []interface{}{
+				map[string]interface{}{
+					"name": "example-gateway",
+				},
+			},
+			"hostname": []interface{}{"example.com"},
+			"rule": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{
+							"method": []interface{}{
+								map[string]interface{}{
+									"service": "example.Service",
+									"method":  "Get",
+								},
+							},
+						},
+					},
+					"backend_ref": []interface{}{
+						map[string]interface{}{
+							"name": "example-service",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	spec := expandGRPCRouteV1Spec(in)
+	if len(spec.Rules) != 1 || spec.Rules[0].Matches[0].Method.Service == nil {
+		t.Fatalf("unexpected rules: %#v", spec.Rules)
+	}
+
+	out := flattenGRPCRouteV1Spec(spec)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestReferenceGrantV1beta1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"from": []interface{}{
+				map[string]interface{}{
+					"group":     "gateway.networking.k8s.io",
+					"kind":      "HTTPRoute",
+					"namespace": "app-namespace",
+				},
+			},
+			"to": []interface{}{
+				map[string]interface{}{
+					"group": "",
+					"kind":  "Service",
+					"name":  "example-service",
+				},
+			},
+		},
+	}
+
+	spec := expandReferenceGrantV1beta1Spec(in)
+	if len(spec.From) != 1 || len(spec.To) != 1 {
+		t.Fatalf("unexpected spec: %#v", spec)
+	}
+
+	out := flattenReferenceGrantV1beta1Spec(spec)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch.\nin:  %#v\nout: %#v", in, out)
+	}
+}
+
+func TestFlattenGatewayAPIConditions(t *testing.T) {
+	in := []metav1.Condition{
+		{
+			Type:    "Programmed",
+			Status:  metav1.ConditionTrue,
+			Reason:  "Programmed",
+			Message: "Gateway has been programmed",
+		},
+	}
+
+	out := flattenGatewayAPIConditions(in)
+	want := []interface{}{
+		map[string]interface{}{
+			"type":    "Programmed",
+			"status":  "True",
+			"reason":  "Programmed",
+			"message": "Gateway has been programmed",
+		},
+	}
+	if !reflect.DeepEqual(want, out) {
+		t.Errorf("unexpected conditions.\nwant: %#v\ngot:  %#v", want, out)
+	}
+}
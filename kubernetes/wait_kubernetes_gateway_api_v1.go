@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// gatewayAPIConditionGetter is satisfied by anything that can report the current
+// set of standard Gateway API conditions (`status.conditions` on GatewayClass and
+// Gateway; `status.parents[].conditions` on the route kinds flatten to this same
+// shape before being passed in).
+type gatewayAPIConditionGetter func(ctx context.Context) ([]metav1.Condition, error)
+
+// waitGatewayAPICondition blocks until every condition of conditionType
+// reported by `get` has status "True", or until timeout elapses. Route
+// getters may flatten more than one condition of the same type in from
+// status.parents (one per parent ref); all of them must be True before this
+// returns, so an Accepted await only succeeds once every parent has accepted
+// the route. It is used to await `Accepted` and `Programmed` conditions
+// across the Gateway API resources, mirroring the load balancer await
+// behavior on kubernetes_ingress_v1.
+func waitGatewayAPICondition(ctx context.Context, get gatewayAPIConditionGetter, conditionType string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		conditions, err := get(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		found := false
+		for _, c := range conditions {
+			if c.Type != conditionType {
+				continue
+			}
+			found = true
+			if c.Status != metav1.ConditionTrue {
+				log.Printf("[DEBUG] Condition %s is %s (%s): %s, retrying", conditionType, c.Status, c.Reason, c.Message)
+				return retry.RetryableError(fmt.Errorf("condition %q is %q: %s", conditionType, c.Status, c.Message))
+			}
+		}
+
+		if !found {
+			log.Printf("[DEBUG] Condition %s not yet reported, retrying", conditionType)
+			return retry.RetryableError(fmt.Errorf("condition %q has not been reported yet", conditionType))
+		}
+
+		return nil
+	})
+}
+
+// flattenRouteParentConditions flattens the per-parent conditions reported on
+// a route's status.parents into the single slice waitGatewayAPICondition
+// expects, so a route awaiting "Accepted" only succeeds once every parent it
+// references has reported it.
+func flattenRouteParentConditions(status gatewayv1.RouteStatus) []metav1.Condition {
+	var conditions []metav1.Condition
+	for _, parent := range status.Parents {
+		conditions = append(conditions, parent.Conditions...)
+	}
+	return conditions
+}
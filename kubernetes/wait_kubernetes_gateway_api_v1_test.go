@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestWaitGatewayAPIConditionSucceeds(t *testing.T) {
+	get := func(ctx context.Context) ([]metav1.Condition, error) {
+		return []metav1.Condition{
+			{Type: "Programmed", Status: metav1.ConditionTrue},
+		}, nil
+	}
+
+	if err := waitGatewayAPICondition(context.Background(), get, "Programmed", time.Second); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestWaitGatewayAPIConditionNeverReported(t *testing.T) {
+	get := func(ctx context.Context) ([]metav1.Condition, error) {
+		return nil, nil
+	}
+
+	err := waitGatewayAPICondition(context.Background(), get, "Programmed", 200*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+}
+
+func TestWaitGatewayAPIConditionRequiresEveryParent(t *testing.T) {
+	get := func(ctx context.Context) ([]metav1.Condition, error) {
+		return []metav1.Condition{
+			{Type: "Accepted", Status: metav1.ConditionTrue},
+			{Type: "Accepted", Status: metav1.ConditionFalse, Reason: "Pending"},
+		}, nil
+	}
+
+	err := waitGatewayAPICondition(context.Background(), get, "Accepted", 200*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error because one parent has not accepted the route, got nil")
+	}
+}
+
+func TestFlattenRouteParentConditions(t *testing.T) {
+	status := gatewayv1.RouteStatus{
+		Parents: []gatewayv1.RouteParentStatus{
+			{
+				ControllerName: "example.com/controller-a",
+				Conditions: []metav1.Condition{
+					{Type: "Accepted", Status: metav1.ConditionTrue},
+				},
+			},
+			{
+				ControllerName: "example.com/controller-b",
+				Conditions: []metav1.Condition{
+					{Type: "Accepted", Status: metav1.ConditionFalse},
+				},
+			},
+		},
+	}
+
+	conditions := flattenRouteParentConditions(status)
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 conditions, got %d: %#v", len(conditions), conditions)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// waitIngressLoadBalancer blocks until the Ingress identified by namespace/name
+// reports at least one `ip` or `hostname` in `status.loadBalancer.ingress[]`, or
+// until timeout elapses. Any events recorded against the Ingress are surfaced in
+// the returned error so the caller can see why an ingress controller has not yet
+// programmed a load balancer.
+func waitIngressLoadBalancer(ctx context.Context, conn kubernetes.Interface, namespace, name string, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		ing, err := conn.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(ing.Status.LoadBalancer.Ingress) > 0 {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Ingress %s/%s has no load balancer ingress yet, retrying", namespace, name)
+		return retry.RetryableError(fmt.Errorf("ingress %q is not ready yet", name))
+	})
+	if err != nil {
+		if events := ingressControllerEvents(ctx, conn, namespace, name); events != "" {
+			return fmt.Errorf("%s\n\nrelevant events:\n%s", err, events)
+		}
+		return err
+	}
+	return nil
+}
+
+// ingressControllerEvents returns a human-readable summary of the events recorded
+// against the given Ingress, in the order the API server lists them, to help explain why the ingress
+// controller has not yet populated the load balancer status.
+func ingressControllerEvents(ctx context.Context, conn kubernetes.Interface, namespace, name string) string {
+	events, err := conn.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=Ingress", name),
+	})
+	if err != nil || len(events.Items) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(events.Items))
+	for _, e := range events.Items {
+		lines = append(lines, fmt.Sprintf("  [%s] %s: %s", e.Type, e.Reason, e.Message))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWaitIngressLoadBalancerSucceeds(t *testing.T) {
+	conn := fake.NewSimpleClientset(&networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+		Status: networking.IngressStatus{
+			LoadBalancer: networking.IngressLoadBalancerStatus{
+				Ingress: []networking.IngressLoadBalancerIngress{
+					{IP: "1.2.3.4"},
+				},
+			},
+		},
+	})
+
+	if err := waitIngressLoadBalancer(context.Background(), conn, "default", "example", time.Second); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestWaitIngressLoadBalancerNeverReady(t *testing.T) {
+	conn := fake.NewSimpleClientset(&networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+	})
+
+	err := waitIngressLoadBalancer(context.Background(), conn, "default", "example", 200*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+}
+
+func TestWaitIngressLoadBalancerNotFound(t *testing.T) {
+	conn := fake.NewSimpleClientset()
+
+	err := waitIngressLoadBalancer(context.Background(), conn, "default", "missing", time.Second)
+	if err == nil {
+		t.Fatal("expected an error for a missing ingress, got nil")
+	}
+}